@@ -0,0 +1,119 @@
+package main
+
+import (
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type User struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Password string `json:"-"`
+}
+
+type RegisterRequest struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required,min=8"`
+}
+
+type LoginRequest struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+type AuthResponse struct {
+	Status string `json:"status"`
+	Token  string `json:"token"`
+}
+
+func registerHandler(ctx *gin.Context) {
+	var req RegisterRequest
+
+	if err := ctx.BindJSON(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+
+	if err != nil {
+		logger.Error("Can't hash password", "error", err)
+		Response := Response{Status: "error", Message: "Can't register user"}
+		ctx.JSON(http.StatusInternalServerError, Response)
+		return
+	}
+
+	var user User
+	row := DB.QueryRow("INSERT INTO users (username, password) VALUES ($1, $2) RETURNING id, username", req.Username, string(hashed))
+
+	if err := row.Scan(&user.ID, &user.Username); err != nil {
+		logger.Error("User already exists")
+		Response := Response{Status: "error", Message: "User already exists"}
+		ctx.JSON(http.StatusConflict, Response)
+		return
+	}
+
+	token, err := generateToken(user.ID)
+
+	if err != nil {
+		logger.Error("Can't generate token", "error", err)
+		Response := Response{Status: "error", Message: "Can't register user"}
+		ctx.JSON(http.StatusInternalServerError, Response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, AuthResponse{Status: "success", Token: token})
+}
+
+func loginHandler(ctx *gin.Context) {
+	var req LoginRequest
+
+	if err := ctx.BindJSON(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	var user User
+	row := DB.QueryRow("SELECT id, username, password FROM users WHERE username=$1", req.Username)
+
+	if err := row.Scan(&user.ID, &user.Username, &user.Password); err != nil {
+		logger.Error("Invalid credentials")
+		Response := Response{Status: "error", Message: "Invalid credentials"}
+		ctx.JSON(http.StatusUnauthorized, Response)
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		logger.Error("Invalid credentials")
+		Response := Response{Status: "error", Message: "Invalid credentials"}
+		ctx.JSON(http.StatusUnauthorized, Response)
+		return
+	}
+
+	token, err := generateToken(user.ID)
+
+	if err != nil {
+		logger.Error("Can't generate token", "error", err)
+		Response := Response{Status: "error", Message: "Can't login"}
+		ctx.JSON(http.StatusInternalServerError, Response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, AuthResponse{Status: "success", Token: token})
+}
+
+func generateToken(userID int) (string, error) {
+	claims := jwt.MapClaims{
+		"sub": userID,
+		"exp": time.Now().Add(72 * time.Hour).Unix(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	return token.SignedString([]byte(os.Getenv("JWT_SECRET")))
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SkillHandler holds the HTTP handlers for the /skills routes. It depends on
+// a SkillRepository rather than the package-level DB so it can be tested
+// against an in-memory store.
+type SkillHandler struct {
+	repo SkillRepository
+}
+
+func NewSkillHandler(repo SkillRepository) *SkillHandler {
+	return &SkillHandler{repo: repo}
+}
+
+func (h *SkillHandler) getSkillsHandler(ctx *gin.Context) {
+	opts := SkillQueryOptions{
+		SortColumn: ctx.DefaultQuery("sort", "key"),
+		SortOrder:  ctx.Query("order"),
+		Search:     ctx.Query("search"),
+	}
+
+	if tag := ctx.Query("tag"); tag != "" {
+		opts.Tags = splitTags(tag)
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", strconv.Itoa(defaultSkillsLimit)))
+	if err != nil || limit <= 0 || limit > maxSkillsLimit {
+		limit = defaultSkillsLimit
+	}
+	opts.Limit = limit
+
+	offset, err := strconv.Atoi(ctx.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	opts.Offset = offset
+
+	skills, total, err := h.repo.GetAll(opts)
+
+	if err != nil {
+		dbQueryErrorsTotal.WithLabelValues("get_all_skills").Inc()
+		logger.Error("Can't get skills", "error", err)
+		Response := Response{Status: "error", Message: "Can't get skills"}
+		ctx.JSON(http.StatusInternalServerError, Response)
+		return
+	}
+
+	getSkillsResponse := GetSkillsResponse{Status: "success", Total: total, Limit: opts.Limit, Offset: opts.Offset, Data: skills}
+	ctx.JSON(http.StatusOK, getSkillsResponse)
+}
+
+func (h *SkillHandler) getSkillByKeyHandler(ctx *gin.Context) {
+	skill, err := h.repo.GetByKey(ctx.Param("key"))
+
+	if err != nil {
+		dbQueryErrorsTotal.WithLabelValues("skill_not_found").Inc()
+		logger.Error("Skill not found", "key", ctx.Param("key"))
+		Response := Response{Status: "error", Message: "Skill not found"}
+		ctx.JSON(http.StatusNotFound, Response)
+		return
+	}
+
+	getSkillResponse := SkillResponse{Status: "success", Data: skill}
+	ctx.JSON(http.StatusOK, getSkillResponse)
+}
+
+func (h *SkillHandler) createSkillHandler(ctx *gin.Context) {
+	var skill Skill
+
+	if !bindJSONOrAbort(ctx, &skill) {
+		return
+	}
+
+	ownerID, _ := currentUserID(ctx)
+	skill.OwnerID = ownerID
+
+	created, err := h.repo.Create(skill)
+
+	if err != nil {
+		dbQueryErrorsTotal.WithLabelValues("skill_already_exists").Inc()
+		logger.Error("Skill already exists", "key", skill.Key)
+		Response := Response{Status: "error", Message: "Skill already exists"}
+		ctx.JSON(http.StatusConflict, Response)
+		return
+	}
+
+	createSkillResponse := SkillResponse{Status: "success", Data: created}
+	ctx.JSON(http.StatusOK, createSkillResponse)
+}
+
+// ErrNotSkillOwner means the skill exists but belongs to a different user.
+var ErrNotSkillOwner = errors.New("not skill owner")
+
+// checkSkillOwnership reports whether the current user owns the skill at
+// key. It returns ErrSkillNotFound when the skill doesn't exist and
+// ErrNotSkillOwner when it exists but belongs to someone else, so callers
+// can tell a missing skill (404) from a real ownership mismatch (403) --
+// GET is public and already reveals which keys exist, so there's no
+// information-hiding benefit to collapsing the two.
+func (h *SkillHandler) checkSkillOwnership(ctx *gin.Context, key string) error {
+	skill, err := h.repo.GetByKey(key)
+
+	if err != nil {
+		return err
+	}
+
+	userID, ok := currentUserID(ctx)
+
+	if !ok || skill.OwnerID != userID {
+		return ErrNotSkillOwner
+	}
+
+	return nil
+}
+
+// updateSkillByKeyHandler is a thin wrapper over applyFieldsAndRespond, the
+// update path patchSkillByKeyHandler also uses: it builds a full merge-patch
+// document from a legacy PUT body, keeping existing
+// clients working while new clients use PATCH directly. The key always comes
+// from the URL, so the body uses its own struct rather than Skill.
+func (h *SkillHandler) updateSkillByKeyHandler(ctx *gin.Context) {
+	var body struct {
+		Name        string   `json:"name" binding:"required"`
+		Description string   `json:"description"`
+		Logo        string   `json:"logo" binding:"omitempty,url"`
+		Tags        []string `json:"tags" binding:"dive,required"`
+	}
+
+	if !bindJSONOrAbort(ctx, &body) {
+		return
+	}
+
+	h.applyFieldsAndRespond(ctx, map[string]interface{}{
+		"name":        body.Name,
+		"description": body.Description,
+		"logo":        body.Logo,
+		"tags":        body.Tags,
+	})
+}
+
+func (h *SkillHandler) updateSkillNameByKeyHandler(ctx *gin.Context) {
+	var body struct {
+		Name string `json:"name" binding:"required"`
+	}
+
+	if !bindJSONOrAbort(ctx, &body) {
+		return
+	}
+
+	h.applyFieldsAndRespond(ctx, map[string]interface{}{"name": body.Name})
+}
+
+func (h *SkillHandler) updateSkillDescriptionByKeyHandler(ctx *gin.Context) {
+	var body struct {
+		Description string `json:"description"`
+	}
+
+	if !bindJSONOrAbort(ctx, &body) {
+		return
+	}
+
+	h.applyFieldsAndRespond(ctx, map[string]interface{}{"description": body.Description})
+}
+
+func (h *SkillHandler) updateSkillLogoByKeyHandler(ctx *gin.Context) {
+	var body struct {
+		Logo string `json:"logo" binding:"omitempty,url"`
+	}
+
+	if !bindJSONOrAbort(ctx, &body) {
+		return
+	}
+
+	h.applyFieldsAndRespond(ctx, map[string]interface{}{"logo": body.Logo})
+}
+
+func (h *SkillHandler) updateSkillTagsByKeyHandler(ctx *gin.Context) {
+	var body struct {
+		Tags []string `json:"tags" binding:"dive,required"`
+	}
+
+	if !bindJSONOrAbort(ctx, &body) {
+		return
+	}
+
+	h.applyFieldsAndRespond(ctx, map[string]interface{}{"tags": body.Tags})
+}
+
+// patchSkillByKeyHandler applies an RFC 7396 JSON Merge Patch to a skill:
+// only the keys present in the body are updated, and a JSON null clears the
+// field to its default.
+func (h *SkillHandler) patchSkillByKeyHandler(ctx *gin.Context) {
+	var patch map[string]interface{}
+
+	if err := ctx.ShouldBindJSON(&patch); err != nil {
+		ctx.JSON(http.StatusBadRequest, Response{Status: "error", Message: err.Error()})
+		return
+	}
+
+	if fieldErrors := validatePatchFields(patch); len(fieldErrors) > 0 {
+		ctx.JSON(http.StatusBadRequest, ValidationErrorResponse{Status: "error", Errors: fieldErrors})
+		return
+	}
+
+	h.applyFieldsAndRespond(ctx, patch)
+}
+
+func (h *SkillHandler) applyFieldsAndRespond(ctx *gin.Context, fields map[string]interface{}) {
+	key := ctx.Param("key")
+
+	if err := h.checkSkillOwnership(ctx, key); err != nil {
+		if errors.Is(err, ErrSkillNotFound) {
+			ctx.JSON(http.StatusNotFound, Response{Status: "error", Message: "Skill not found"})
+			return
+		}
+
+		ctx.JSON(http.StatusForbidden, Response{Status: "error", Message: "You don't own this skill"})
+		return
+	}
+
+	skill, err := h.repo.UpdateField(key, fields)
+
+	if err != nil {
+		message := "not be able to update skill"
+		operation := "update_skill"
+		if errors.Is(err, ErrSkillNotFound) {
+			message = "Skill not found"
+			operation = "skill_not_found"
+		}
+
+		dbQueryErrorsTotal.WithLabelValues(operation).Inc()
+		logger.Error(message, "key", key, "error", err)
+		Response := Response{Status: "error", Message: message}
+		ctx.JSON(http.StatusBadRequest, Response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, SkillResponse{Status: "success", Data: skill})
+}
+
+func (h *SkillHandler) deleteSkillByKeyHandler(ctx *gin.Context) {
+	paramKey := ctx.Param("key")
+
+	if err := h.checkSkillOwnership(ctx, paramKey); err != nil {
+		if errors.Is(err, ErrSkillNotFound) {
+			ctx.JSON(http.StatusNotFound, Response{Status: "error", Message: "Skill not found"})
+			return
+		}
+
+		ctx.JSON(http.StatusForbidden, Response{Status: "error", Message: "You don't own this skill"})
+		return
+	}
+
+	_, err := h.repo.Delete(paramKey)
+
+	if err != nil {
+		dbQueryErrorsTotal.WithLabelValues("delete_skill").Inc()
+		logger.Error("not be able to delete skill", "key", paramKey, "error", err)
+		Response := Response{Status: "error", Message: "not be able to delete skill"}
+		ctx.JSON(http.StatusBadRequest, Response)
+		return
+	}
+
+	Response := Response{Status: "success", Message: "Skill deleted"}
+	ctx.JSON(http.StatusOK, Response)
+}
+
+func splitTags(tag string) []string {
+	var tags []string
+
+	for _, t := range strings.Split(tag, ",") {
+		if t != "" {
+			tags = append(tags, t)
+		}
+	}
+
+	return tags
+}
@@ -0,0 +1,338 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func newTestHandler() *SkillHandler {
+	return NewSkillHandler(newInMemorySkillRepository())
+}
+
+func newJSONContext(method, body string) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(method, "/", bytes.NewBufferString(body))
+	ctx.Request.Header.Set("Content-Type", "application/json")
+
+	return ctx, w
+}
+
+func asOwner(ctx *gin.Context, userID int) {
+	ctx.Set(userIDContextKey, userID)
+}
+
+func TestCreateSkillHandler(t *testing.T) {
+	h := newTestHandler()
+
+	ctx, w := newJSONContext(http.MethodPost, `{"key":"go","name":"Go","tags":["backend"]}`)
+	asOwner(ctx, 1)
+
+	h.createSkillHandler(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp SkillResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("can't decode response: %v", err)
+	}
+
+	if resp.Data.OwnerID != 1 {
+		t.Fatalf("expected owner_id 1, got %d", resp.Data.OwnerID)
+	}
+}
+
+func TestCreateSkillHandlerValidation(t *testing.T) {
+	h := newTestHandler()
+
+	ctx, w := newJSONContext(http.MethodPost, `{"key":"g"}`)
+	asOwner(ctx, 1)
+
+	h.createSkillHandler(ctx)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", w.Code)
+	}
+}
+
+func TestCreateSkillHandlerConflict(t *testing.T) {
+	h := newTestHandler()
+	if _, err := h.repo.Create(Skill{Key: "go", Name: "Go", OwnerID: 1}); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+
+	ctx, w := newJSONContext(http.MethodPost, `{"key":"go","name":"Go"}`)
+	asOwner(ctx, 1)
+
+	h.createSkillHandler(ctx)
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected 409, got %d", w.Code)
+	}
+}
+
+func TestGetSkillByKeyHandlerNotFound(t *testing.T) {
+	h := newTestHandler()
+
+	ctx, w := newJSONContext(http.MethodGet, "")
+	ctx.Params = gin.Params{{Key: "key", Value: "missing"}}
+
+	h.getSkillByKeyHandler(ctx)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", w.Code)
+	}
+}
+
+func TestGetSkillsHandlerPagination(t *testing.T) {
+	h := newTestHandler()
+	if _, err := h.repo.Create(Skill{Key: "go", Name: "Go", OwnerID: 1}); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+	if _, err := h.repo.Create(Skill{Key: "rust", Name: "Rust", OwnerID: 1}); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+
+	ctx, w := newJSONContext(http.MethodGet, "")
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/?limit=1&offset=0", nil)
+
+	h.getSkillsHandler(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+
+	var resp GetSkillsResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("can't decode response: %v", err)
+	}
+
+	if resp.Total != 2 || len(resp.Data) != 1 {
+		t.Fatalf("expected total 2 and 1 item, got total=%d len=%d", resp.Total, len(resp.Data))
+	}
+}
+
+// failingGetAllRepository wraps inMemorySkillRepository to simulate a
+// GetAll query failure without standing up a real Postgres instance.
+type failingGetAllRepository struct {
+	*inMemorySkillRepository
+}
+
+func (r *failingGetAllRepository) GetAll(opts SkillQueryOptions) ([]Skill, int, error) {
+	return nil, 0, errors.New("connection refused")
+}
+
+func TestGetSkillsHandlerGetAllError(t *testing.T) {
+	h := NewSkillHandler(&failingGetAllRepository{newInMemorySkillRepository()})
+
+	ctx, w := newJSONContext(http.MethodGet, "")
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+	h.getSkillsHandler(ctx)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected 500, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestPatchSkillByKeyHandlerForbidden(t *testing.T) {
+	h := newTestHandler()
+	if _, err := h.repo.Create(Skill{Key: "go", Name: "Go", OwnerID: 1}); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+
+	ctx, w := newJSONContext(http.MethodPatch, `{"name":"Golang"}`)
+	ctx.Params = gin.Params{{Key: "key", Value: "go"}}
+	asOwner(ctx, 2)
+
+	h.patchSkillByKeyHandler(ctx)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", w.Code)
+	}
+}
+
+func TestPatchSkillByKeyHandler(t *testing.T) {
+	h := newTestHandler()
+	if _, err := h.repo.Create(Skill{Key: "go", Name: "Go", Description: "lang", OwnerID: 1}); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+
+	ctx, w := newJSONContext(http.MethodPatch, `{"name":"Golang","logo":null}`)
+	ctx.Params = gin.Params{{Key: "key", Value: "go"}}
+	asOwner(ctx, 1)
+
+	h.patchSkillByKeyHandler(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp SkillResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("can't decode response: %v", err)
+	}
+
+	if resp.Data.Name != "Golang" || resp.Data.Description != "lang" {
+		t.Fatalf("unexpected patch result: %+v", resp.Data)
+	}
+}
+
+func TestPatchSkillByKeyHandlerValidation(t *testing.T) {
+	h := newTestHandler()
+	if _, err := h.repo.Create(Skill{Key: "go", Name: "Go", OwnerID: 1}); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+
+	ctx, w := newJSONContext(http.MethodPatch, `{"logo":"not-a-url"}`)
+	ctx.Params = gin.Params{{Key: "key", Value: "go"}}
+	asOwner(ctx, 1)
+
+	h.patchSkillByKeyHandler(ctx)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", w.Code, w.Body.String())
+	}
+
+	skill, err := h.repo.GetByKey("go")
+	if err != nil {
+		t.Fatalf("seed skill vanished: %v", err)
+	}
+
+	if skill.Logo != "" {
+		t.Fatalf("expected invalid logo to be rejected, got %q persisted", skill.Logo)
+	}
+}
+
+func TestUpdateSkillByKeyHandler(t *testing.T) {
+	h := newTestHandler()
+	if _, err := h.repo.Create(Skill{Key: "go", Name: "Go", Description: "lang", OwnerID: 1}); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+
+	ctx, w := newJSONContext(http.MethodPut, `{"name":"Golang","tags":["backend"]}`)
+	ctx.Params = gin.Params{{Key: "key", Value: "go"}}
+	asOwner(ctx, 1)
+
+	h.updateSkillByKeyHandler(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp SkillResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("can't decode response: %v", err)
+	}
+
+	if resp.Data.Name != "Golang" || len(resp.Data.Tags) != 1 || resp.Data.Tags[0] != "backend" {
+		t.Fatalf("unexpected update result: %+v", resp.Data)
+	}
+}
+
+func TestUpdateSkillTagsByKeyHandler(t *testing.T) {
+	h := newTestHandler()
+	if _, err := h.repo.Create(Skill{Key: "go", Name: "Go", OwnerID: 1}); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+
+	ctx, w := newJSONContext(http.MethodPatch, `{"tags":["backend","systems"]}`)
+	ctx.Params = gin.Params{{Key: "key", Value: "go"}}
+	asOwner(ctx, 1)
+
+	h.updateSkillTagsByKeyHandler(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp SkillResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("can't decode response: %v", err)
+	}
+
+	if len(resp.Data.Tags) != 2 || resp.Data.Tags[0] != "backend" || resp.Data.Tags[1] != "systems" {
+		t.Fatalf("unexpected tags after update: %+v", resp.Data.Tags)
+	}
+}
+
+func TestUpdateSkillByKeyHandlerMissingKeyInBody(t *testing.T) {
+	h := newTestHandler()
+	if _, err := h.repo.Create(Skill{Key: "go", Name: "Go", OwnerID: 1}); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+
+	ctx, w := newJSONContext(http.MethodPut, `{"name":"Golang"}`)
+	ctx.Params = gin.Params{{Key: "key", Value: "go"}}
+	asOwner(ctx, 1)
+
+	h.updateSkillByKeyHandler(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200 for a body without key (key always comes from the URL), got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestDeleteSkillByKeyHandlerNotFound(t *testing.T) {
+	h := newTestHandler()
+
+	ctx, w := newJSONContext(http.MethodDelete, "")
+	ctx.Params = gin.Params{{Key: "key", Value: "missing"}}
+	asOwner(ctx, 1)
+
+	h.deleteSkillByKeyHandler(ctx)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 when the skill doesn't exist, got %d", w.Code)
+	}
+}
+
+func TestDeleteSkillByKeyHandlerForbidden(t *testing.T) {
+	h := newTestHandler()
+	if _, err := h.repo.Create(Skill{Key: "go", Name: "Go", OwnerID: 1}); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+
+	ctx, w := newJSONContext(http.MethodDelete, "")
+	ctx.Params = gin.Params{{Key: "key", Value: "go"}}
+	asOwner(ctx, 2)
+
+	h.deleteSkillByKeyHandler(ctx)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 when the skill exists but belongs to someone else, got %d", w.Code)
+	}
+}
+
+func TestDeleteSkillByKeyHandler(t *testing.T) {
+	h := newTestHandler()
+	if _, err := h.repo.Create(Skill{Key: "go", Name: "Go", OwnerID: 1}); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+
+	ctx, w := newJSONContext(http.MethodDelete, "")
+	ctx.Params = gin.Params{{Key: "key", Value: "go"}}
+	asOwner(ctx, 1)
+
+	h.deleteSkillByKeyHandler(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+
+	if _, err := h.repo.GetByKey("go"); err == nil {
+		t.Fatalf("expected skill to be deleted")
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var logger = slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+const requestIDHeader = "X-Request-Id"
+
+// requestLoggerMiddleware emits a structured log line and a Prometheus
+// observation for every request, tagging each with a request id so log
+// lines for the same request can be correlated.
+func requestLoggerMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+
+		requestID := ctx.GetHeader(requestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		ctx.Writer.Header().Set(requestIDHeader, requestID)
+
+		ctx.Next()
+
+		latency := time.Since(start)
+		status := ctx.Writer.Status()
+
+		logger.Info("request",
+			"method", ctx.Request.Method,
+			"path", ctx.FullPath(),
+			"status", status,
+			"latency_ms", latency.Milliseconds(),
+			"request_id", requestID,
+		)
+
+		httpRequestDuration.WithLabelValues(ctx.FullPath(), ctx.Request.Method, strconv.Itoa(status)).Observe(latency.Seconds())
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/sittijedw/skill-management-api/migrations"
+)
+
+type Skill struct {
+	Key         string   `json:"key" binding:"required,alphanum,min=2,max=64"`
+	Name        string   `json:"name" binding:"required"`
+	Description string   `json:"description"`
+	Logo        string   `json:"logo" binding:"omitempty,url"`
+	Tags        []string `json:"tags" binding:"dive,required"`
+	OwnerID     int      `json:"owner_id"`
+}
+
+type GetSkillsResponse struct {
+	Status string  `json:"status"`
+	Total  int     `json:"total"`
+	Limit  int     `json:"limit"`
+	Offset int     `json:"offset"`
+	Data   []Skill `json:"data"`
+}
+
+type SkillResponse struct {
+	Status string `json:"status"`
+	Data   Skill  `json:"data"`
+}
+
+type Response struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+const (
+	defaultSkillsLimit = 20
+	maxSkillsLimit     = 100
+)
+
+var DB *sql.DB
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	DB = connectDB()
+	defer DB.Close()
+
+	if err := migrations.Up(DB); err != nil {
+		logger.Error("Can't apply migrations", "error", err)
+		os.Exit(1)
+	}
+
+	skillHandler := NewSkillHandler(newPostgresSkillRepository(DB))
+
+	r := gin.Default()
+	r.Use(requestLoggerMiddleware())
+	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	v1 := r.Group("/api/v1")
+	{
+		auth := v1.Group("/auth")
+		{
+			auth.POST("/register", registerHandler)
+			auth.POST("/login", loginHandler)
+		}
+
+		v1.GET("/skills", skillHandler.getSkillsHandler)
+		v1.GET("/skills/:key", skillHandler.getSkillByKeyHandler)
+
+		skills := v1.Group("/skills")
+		skills.Use(authMiddleware())
+		{
+			skills.POST("", skillHandler.createSkillHandler)
+			skills.PUT("/:key", skillHandler.updateSkillByKeyHandler)
+			skills.PATCH("/:key", skillHandler.patchSkillByKeyHandler)
+			skills.PATCH("/:key/actions/name", skillHandler.updateSkillNameByKeyHandler)
+			skills.PATCH("/:key/actions/description", skillHandler.updateSkillDescriptionByKeyHandler)
+			skills.PATCH("/:key/actions/logo", skillHandler.updateSkillLogoByKeyHandler)
+			skills.PATCH("/:key/actions/tags", skillHandler.updateSkillTagsByKeyHandler)
+			skills.DELETE("/:key", skillHandler.deleteSkillByKeyHandler)
+		}
+	}
+
+	srv := http.Server{
+		Addr:    ":" + os.Getenv("PORT"),
+		Handler: r,
+	}
+
+	closedChan := make(chan struct{})
+
+	go func() {
+		<-ctx.Done()
+		logger.Info("Shutting down...")
+
+		ctx, cancel = context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		if err := srv.Shutdown(ctx); err != nil {
+			if !errors.Is(err, http.ErrServerClosed) {
+				logger.Error(err.Error())
+			}
+		}
+		close(closedChan)
+	}()
+
+	if err := srv.ListenAndServe(); err != nil {
+		logger.Error(err.Error())
+	}
+
+	<-closedChan
+}
+
+func connectDB() *sql.DB {
+	dbUrl := os.Getenv("DATABASE_URL")
+	db, err := sql.Open("postgres", dbUrl)
+
+	if err != nil {
+		logger.Error("Can't connect to database", "error", err)
+	} else {
+		logger.Info("Connect database success")
+	}
+
+	return db
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// inMemorySkillRepository is a SkillRepository backed by a map, used in
+// handler tests so they don't require a live Postgres instance.
+type inMemorySkillRepository struct {
+	mu     sync.Mutex
+	skills map[string]Skill
+}
+
+func newInMemorySkillRepository() *inMemorySkillRepository {
+	return &inMemorySkillRepository{skills: make(map[string]Skill)}
+}
+
+func (r *inMemorySkillRepository) GetAll(opts SkillQueryOptions) ([]Skill, int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	skills := make([]Skill, 0, len(r.skills))
+	for _, skill := range r.skills {
+		if len(opts.Tags) > 0 && !hasAnyTag(skill.Tags, opts.Tags) {
+			continue
+		}
+
+		if opts.Search != "" && !containsFold(skill.Name, opts.Search) && !containsFold(skill.Description, opts.Search) {
+			continue
+		}
+
+		skills = append(skills, skill)
+	}
+
+	sort.Slice(skills, func(i, j int) bool {
+		less := skillFieldLess(skills[i], skills[j], opts.SortColumn)
+
+		if strings.EqualFold(opts.SortOrder, "desc") {
+			return !less
+		}
+
+		return less
+	})
+
+	total := len(skills)
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = defaultSkillsLimit
+	}
+
+	offset := opts.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	if offset >= len(skills) {
+		return []Skill{}, total, nil
+	}
+
+	end := offset + limit
+	if end > len(skills) {
+		end = len(skills)
+	}
+
+	return skills[offset:end], total, nil
+}
+
+func (r *inMemorySkillRepository) GetByKey(key string) (Skill, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	skill, ok := r.skills[key]
+
+	if !ok {
+		return Skill{}, ErrSkillNotFound
+	}
+
+	return skill, nil
+}
+
+func (r *inMemorySkillRepository) Create(skill Skill) (Skill, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.skills[skill.Key]; ok {
+		return Skill{}, ErrSkillAlreadyExists
+	}
+
+	r.skills[skill.Key] = skill
+
+	return skill, nil
+}
+
+func (r *inMemorySkillRepository) Update(skill Skill) (Skill, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.skills[skill.Key]
+
+	if !ok {
+		return Skill{}, ErrSkillNotFound
+	}
+
+	skill.OwnerID = existing.OwnerID
+	r.skills[skill.Key] = skill
+
+	return skill, nil
+}
+
+func (r *inMemorySkillRepository) UpdateField(key string, fields map[string]interface{}) (Skill, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	skill, ok := r.skills[key]
+
+	if !ok {
+		return Skill{}, ErrSkillNotFound
+	}
+
+	for field, value := range fields {
+		if !patchableSkillColumns[field] {
+			continue
+		}
+
+		switch field {
+		case "name":
+			if value == nil {
+				skill.Name = ""
+			} else if str, ok := value.(string); ok {
+				skill.Name = str
+			}
+		case "description":
+			if value == nil {
+				skill.Description = ""
+			} else if str, ok := value.(string); ok {
+				skill.Description = str
+			}
+		case "logo":
+			if value == nil {
+				skill.Logo = ""
+			} else if str, ok := value.(string); ok {
+				skill.Logo = str
+			}
+		case "tags":
+			if value == nil {
+				skill.Tags = []string{}
+			} else if tags, err := toStringSlice(value); err == nil {
+				skill.Tags = tags
+			}
+		}
+	}
+
+	r.skills[key] = skill
+
+	return skill, nil
+}
+
+func (r *inMemorySkillRepository) Delete(key string) (Skill, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	skill, ok := r.skills[key]
+
+	if !ok {
+		return Skill{}, ErrSkillNotFound
+	}
+
+	delete(r.skills, key)
+
+	return skill, nil
+}
+
+func hasAnyTag(tags []string, filter []string) bool {
+	for _, t := range tags {
+		for _, f := range filter {
+			if t == f {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+func skillFieldLess(a, b Skill, column string) bool {
+	switch column {
+	case "name":
+		return a.Name < b.Name
+	case "description":
+		return a.Description < b.Description
+	default:
+		return a.Key < b.Key
+	}
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var httpRequestDuration = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name: "http_request_duration_seconds",
+		Help: "HTTP request latency in seconds by route, method, and status",
+	},
+	[]string{"route", "method", "status"},
+)
+
+var dbQueryErrorsTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "db_query_errors_total",
+		Help: "Count of database query errors by operation",
+	},
+	[]string{"operation"},
+)
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const userIDContextKey = "userID"
+
+func authMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		header := ctx.GetHeader("Authorization")
+		parts := strings.SplitN(header, " ", 2)
+
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			Response := Response{Status: "error", Message: "Missing or invalid authorization header"}
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, Response)
+			return
+		}
+
+		claims := jwt.MapClaims{}
+		token, err := jwt.ParseWithClaims(parts[1], claims, func(token *jwt.Token) (interface{}, error) {
+			return []byte(os.Getenv("JWT_SECRET")), nil
+		})
+
+		if err != nil || !token.Valid {
+			Response := Response{Status: "error", Message: "Invalid or expired token"}
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, Response)
+			return
+		}
+
+		sub, ok := claims["sub"].(float64)
+
+		if !ok {
+			Response := Response{Status: "error", Message: "Invalid token claims"}
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, Response)
+			return
+		}
+
+		ctx.Set(userIDContextKey, int(sub))
+		ctx.Next()
+	}
+}
+
+func currentUserID(ctx *gin.Context) (int, bool) {
+	id, ok := ctx.Get(userIDContextKey)
+
+	if !ok {
+		return 0, false
+	}
+
+	userID, ok := id.(int)
+
+	return userID, ok
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+
+	_ "github.com/lib/pq"
+
+	"github.com/sittijedw/skill-management-api/migrations"
+)
+
+func main() {
+	if len(os.Args) < 3 || os.Args[1] != "migrate" {
+		fmt.Println("usage: migration migrate <up|down|version>")
+		os.Exit(1)
+	}
+
+	db := connectDB()
+	defer db.Close()
+
+	switch os.Args[2] {
+	case "up":
+		if err := migrations.Up(db); err != nil {
+			log.Println("Error: migrate up failed", err)
+			os.Exit(1)
+		}
+		log.Println("migrate up success")
+	case "down":
+		if err := migrations.Down(db); err != nil {
+			log.Println("Error: migrate down failed", err)
+			os.Exit(1)
+		}
+		log.Println("migrate down success")
+	case "version":
+		version, dirty, err := migrations.Version(db)
+		if err != nil {
+			log.Println("Error: migrate version failed", err)
+			os.Exit(1)
+		}
+		fmt.Printf("version: %d, dirty: %v\n", version, dirty)
+	default:
+		fmt.Println("usage: migration migrate <up|down|version>")
+		os.Exit(1)
+	}
+}
+
+func connectDB() *sql.DB {
+	url := os.Getenv("DATABASE_URL")
+	db, err := sql.Open("postgres", url)
+
+	if err != nil {
+		log.Println("Error: Can't connect to database", err)
+	} else {
+		log.Println("Connect database success")
+	}
+
+	return db
+}
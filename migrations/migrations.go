@@ -0,0 +1,230 @@
+// Package migrations tracks and applies numbered SQL schema migrations,
+// recording progress in a schema_migrations bookkeeping table so deployments
+// are idempotent and reversible.
+package migrations
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+type migration struct {
+	version int
+	up      string
+	down    string
+}
+
+func load() ([]migration, error) {
+	entries, err := files.ReadDir(".")
+
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*migration{}
+
+	for _, entry := range entries {
+		version, direction, ok := parseFilename(entry.Name())
+
+		if !ok {
+			continue
+		}
+
+		content, err := files.ReadFile(entry.Name())
+
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{version: version}
+			byVersion[version] = m
+		}
+
+		if direction == "up" {
+			m.up = string(content)
+		} else {
+			m.down = string(content)
+		}
+	}
+
+	all := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		all = append(all, *m)
+	}
+
+	sort.Slice(all, func(i, j int) bool { return all[i].version < all[j].version })
+
+	return all, nil
+}
+
+func parseFilename(name string) (int, string, bool) {
+	prefix, _, ok := strings.Cut(name, "_")
+
+	if !ok {
+		return 0, "", false
+	}
+
+	version, err := strconv.Atoi(prefix)
+
+	if err != nil {
+		return 0, "", false
+	}
+
+	switch {
+	case strings.HasSuffix(name, ".up.sql"):
+		return version, "up", true
+	case strings.HasSuffix(name, ".down.sql"):
+		return version, "down", true
+	default:
+		return 0, "", false
+	}
+}
+
+func ensureVersionTable(db *sql.DB) error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY, dirty BOOLEAN NOT NULL DEFAULT false)`)
+
+	return err
+}
+
+func currentVersion(db *sql.DB) (int, bool, error) {
+	var version int
+	var dirty bool
+
+	row := db.QueryRow("SELECT version, dirty FROM schema_migrations ORDER BY version DESC LIMIT 1")
+	err := row.Scan(&version, &dirty)
+
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+
+	return version, dirty, err
+}
+
+// Up applies every migration newer than the current version, in order.
+func Up(db *sql.DB) error {
+	if err := ensureVersionTable(db); err != nil {
+		return err
+	}
+
+	current, dirty, err := currentVersion(db)
+
+	if err != nil {
+		return err
+	}
+
+	if dirty {
+		return fmt.Errorf("database is dirty at version %d, fix manually before migrating", current)
+	}
+
+	all, err := load()
+
+	if err != nil {
+		return err
+	}
+
+	for _, m := range all {
+		if m.version <= current {
+			continue
+		}
+
+		if err := apply(db, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Down reverts the current migration version, one step.
+func Down(db *sql.DB) error {
+	if err := ensureVersionTable(db); err != nil {
+		return err
+	}
+
+	current, dirty, err := currentVersion(db)
+
+	if err != nil {
+		return err
+	}
+
+	if dirty {
+		return fmt.Errorf("database is dirty at version %d, fix manually before migrating", current)
+	}
+
+	if current == 0 {
+		return nil
+	}
+
+	all, err := load()
+
+	if err != nil {
+		return err
+	}
+
+	for _, m := range all {
+		if m.version == current {
+			return revert(db, m)
+		}
+	}
+
+	return fmt.Errorf("no migration found for version %d", current)
+}
+
+// Version reports the current schema version and whether it's dirty (i.e.
+// a previous migration failed partway through).
+func Version(db *sql.DB) (int, bool, error) {
+	if err := ensureVersionTable(db); err != nil {
+		return 0, false, err
+	}
+
+	return currentVersion(db)
+}
+
+func apply(db *sql.DB, m migration) error {
+	tx, err := db.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(m.up); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("migration %d up failed: %w", m.version, err)
+	}
+
+	if _, err := tx.Exec("INSERT INTO schema_migrations (version, dirty) VALUES ($1, false) ON CONFLICT (version) DO UPDATE SET dirty=false", m.version); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func revert(db *sql.DB, m migration) error {
+	tx, err := db.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(m.down); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("migration %d down failed: %w", m.version, err)
+	}
+
+	if _, err := tx.Exec("DELETE FROM schema_migrations WHERE version=$1", m.version); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+type postgresSkillRepository struct {
+	db *sql.DB
+}
+
+func newPostgresSkillRepository(db *sql.DB) *postgresSkillRepository {
+	return &postgresSkillRepository{db: db}
+}
+
+var sortableSkillColumns = map[string]bool{
+	"key":         true,
+	"name":        true,
+	"description": true,
+}
+
+var patchableSkillColumns = map[string]bool{
+	"name":        true,
+	"description": true,
+	"logo":        true,
+	"tags":        true,
+}
+
+func scanSkill(row *sql.Row) (Skill, error) {
+	var skill Skill
+	err := row.Scan(&skill.Key, &skill.Name, &skill.Description, &skill.Logo, pq.Array(&skill.Tags), &skill.OwnerID)
+
+	return skill, err
+}
+
+func (r *postgresSkillRepository) GetAll(opts SkillQueryOptions) ([]Skill, int, error) {
+	var (
+		args       []interface{}
+		conditions []string
+	)
+
+	if len(opts.Tags) > 0 {
+		args = append(args, pq.Array(opts.Tags))
+		conditions = append(conditions, fmt.Sprintf("tags && $%d::text[]", len(args)))
+	}
+
+	if opts.Search != "" {
+		args = append(args, "%"+opts.Search+"%")
+		conditions = append(conditions, fmt.Sprintf("(name ILIKE $%d OR description ILIKE $%d)", len(args), len(args)))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	sortColumn := opts.SortColumn
+	if !sortableSkillColumns[sortColumn] {
+		sortColumn = "key"
+	}
+
+	sortOrder := "ASC"
+	if strings.EqualFold(opts.SortOrder, "desc") {
+		sortOrder = "DESC"
+	}
+
+	var total int
+	countQuery := "SELECT count(*) FROM skill" + where
+
+	if err := r.db.QueryRow(countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := fmt.Sprintf("SELECT key, name, description, logo, tags, owner_id FROM skill%s ORDER BY %s %s LIMIT $%d OFFSET $%d", where, sortColumn, sortOrder, len(args)+1, len(args)+2)
+	args = append(args, opts.Limit, opts.Offset)
+
+	rows, err := r.db.Query(query, args...)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+
+	skills := make([]Skill, 0)
+	for rows.Next() {
+		var skill Skill
+
+		if err := rows.Scan(&skill.Key, &skill.Name, &skill.Description, &skill.Logo, pq.Array(&skill.Tags), &skill.OwnerID); err != nil {
+			return nil, 0, err
+		}
+
+		skills = append(skills, skill)
+	}
+
+	return skills, total, nil
+}
+
+func (r *postgresSkillRepository) GetByKey(key string) (Skill, error) {
+	row := r.db.QueryRow("SELECT key, name, description, logo, tags, owner_id FROM skill WHERE key=$1", key)
+
+	skill, err := scanSkill(row)
+
+	if err == sql.ErrNoRows {
+		return Skill{}, ErrSkillNotFound
+	}
+
+	return skill, err
+}
+
+func (r *postgresSkillRepository) Create(skill Skill) (Skill, error) {
+	row := r.db.QueryRow("INSERT INTO skill (key, name, description, logo, tags, owner_id) VALUES ($1, $2, $3, $4, $5, $6) RETURNING key, name, description, logo, tags, owner_id", skill.Key, skill.Name, skill.Description, skill.Logo, pq.Array(skill.Tags), skill.OwnerID)
+
+	newSkill, err := scanSkill(row)
+
+	if err != nil {
+		return Skill{}, ErrSkillAlreadyExists
+	}
+
+	return newSkill, nil
+}
+
+func (r *postgresSkillRepository) Update(skill Skill) (Skill, error) {
+	row := r.db.QueryRow("UPDATE skill SET name=$1, description=$2, logo=$3, tags=$4 WHERE key=$5 RETURNING key, name, description, logo, tags, owner_id", skill.Name, skill.Description, skill.Logo, pq.Array(skill.Tags), skill.Key)
+
+	updated, err := scanSkill(row)
+
+	if err == sql.ErrNoRows {
+		return Skill{}, ErrSkillNotFound
+	}
+
+	return updated, err
+}
+
+func (r *postgresSkillRepository) UpdateField(key string, fields map[string]interface{}) (Skill, error) {
+	var setClauses []string
+	var args []interface{}
+
+	for _, field := range []string{"name", "description", "logo", "tags"} {
+		value, present := fields[field]
+
+		if !present || !patchableSkillColumns[field] {
+			continue
+		}
+
+		if value == nil {
+			if field == "tags" {
+				args = append(args, pq.Array([]string{}))
+			} else {
+				args = append(args, "")
+			}
+		} else if field == "tags" {
+			tags, err := toStringSlice(value)
+
+			if err != nil {
+				return Skill{}, err
+			}
+
+			args = append(args, pq.Array(tags))
+		} else {
+			str, ok := value.(string)
+
+			if !ok {
+				return Skill{}, fmt.Errorf("%s must be a string", field)
+			}
+
+			args = append(args, str)
+		}
+
+		setClauses = append(setClauses, fmt.Sprintf("%s=$%d", field, len(args)))
+	}
+
+	if len(setClauses) == 0 {
+		return r.GetByKey(key)
+	}
+
+	args = append(args, key)
+
+	var builder strings.Builder
+	builder.WriteString("UPDATE skill SET ")
+	builder.WriteString(strings.Join(setClauses, ", "))
+	builder.WriteString(fmt.Sprintf(" WHERE key=$%d RETURNING key, name, description, logo, tags, owner_id", len(args)))
+
+	row := r.db.QueryRow(builder.String(), args...)
+	updated, err := scanSkill(row)
+
+	if err == sql.ErrNoRows {
+		return Skill{}, ErrSkillNotFound
+	}
+
+	return updated, err
+}
+
+func (r *postgresSkillRepository) Delete(key string) (Skill, error) {
+	row := r.db.QueryRow("DELETE FROM skill WHERE key=$1 RETURNING key, name, description, logo, tags, owner_id", key)
+
+	skill, err := scanSkill(row)
+
+	if err == sql.ErrNoRows {
+		return Skill{}, ErrSkillNotFound
+	}
+
+	return skill, err
+}
+
+// toStringSlice accepts both []string (built in-process by handlers that
+// bind straight into a struct, e.g. the PUT and /actions/tags handlers) and
+// []interface{} (what json.Unmarshal produces for the PATCH merge-patch map)
+// so UpdateField's tags handling works the same regardless of caller.
+func toStringSlice(value interface{}) ([]string, error) {
+	if tags, ok := value.([]string); ok {
+		return tags, nil
+	}
+
+	raw, ok := value.([]interface{})
+
+	if !ok {
+		return nil, fmt.Errorf("tags must be an array of strings")
+	}
+
+	tags := make([]string, 0, len(raw))
+	for _, item := range raw {
+		tag, ok := item.(string)
+
+		if !ok {
+			return nil, fmt.Errorf("tags must be an array of strings")
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,30 @@
+package main
+
+import "errors"
+
+var (
+	ErrSkillNotFound      = errors.New("skill not found")
+	ErrSkillAlreadyExists = errors.New("skill already exists")
+)
+
+// SkillQueryOptions carries the filter, sort, and pagination parameters for
+// SkillRepository.GetAll.
+type SkillQueryOptions struct {
+	Tags       []string
+	Search     string
+	SortColumn string
+	SortOrder  string
+	Limit      int
+	Offset     int
+}
+
+// SkillRepository abstracts skill persistence so handlers can be tested
+// without a live Postgres instance.
+type SkillRepository interface {
+	GetAll(opts SkillQueryOptions) ([]Skill, int, error)
+	GetByKey(key string) (Skill, error)
+	Create(skill Skill) (Skill, error)
+	Update(skill Skill) (Skill, error)
+	UpdateField(key string, fields map[string]interface{}) (Skill, error)
+	Delete(key string) (Skill, error)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+type ValidationErrorResponse struct {
+	Status string       `json:"status"`
+	Errors []FieldError `json:"errors"`
+}
+
+// bindJSONOrAbort binds the request body into obj and, on failure, writes a
+// structured 400 response. It returns false when the caller should stop
+// processing the request.
+func bindJSONOrAbort(ctx *gin.Context, obj interface{}) bool {
+	if err := ctx.ShouldBindJSON(obj); err != nil {
+		var validationErrors validator.ValidationErrors
+
+		if errors.As(err, &validationErrors) {
+			ctx.JSON(http.StatusBadRequest, ValidationErrorResponse{Status: "error", Errors: translateValidationErrors(validationErrors)})
+			return false
+		}
+
+		ctx.JSON(http.StatusBadRequest, Response{Status: "error", Message: err.Error()})
+		return false
+	}
+
+	return true
+}
+
+func translateValidationErrors(validationErrors validator.ValidationErrors) []FieldError {
+	fieldErrors := make([]FieldError, 0, len(validationErrors))
+
+	for _, fe := range validationErrors {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   fe.Field(),
+			Rule:    fe.Tag(),
+			Message: fieldErrorMessage(fe),
+		})
+	}
+
+	return fieldErrors
+}
+
+var fieldValidator = validator.New()
+
+// validatePatchFields checks each whitelisted key present in a JSON
+// merge-patch document against the same rules the struct-based handlers
+// (createSkillHandler, the /actions/* routes) enforce via binding tags, so
+// PATCH can't bypass them. A JSON null -- the merge-patch marker for "clear
+// this field" -- skips validation and is left to UpdateField to reset the
+// field to its zero value.
+func validatePatchFields(patch map[string]interface{}) []FieldError {
+	var fieldErrors []FieldError
+
+	if value, present := patch["name"]; present && value != nil {
+		if str, ok := value.(string); !ok || str == "" {
+			fieldErrors = append(fieldErrors, FieldError{Field: "Name", Rule: "required", Message: "Name is required"})
+		}
+	}
+
+	if value, present := patch["logo"]; present && value != nil {
+		str, ok := value.(string)
+		if !ok || (str != "" && fieldValidator.Var(str, "url") != nil) {
+			fieldErrors = append(fieldErrors, FieldError{Field: "Logo", Rule: "url", Message: "Logo must be a valid URL"})
+		}
+	}
+
+	if value, present := patch["tags"]; present && value != nil {
+		tags, err := toStringSlice(value)
+		if err != nil {
+			fieldErrors = append(fieldErrors, FieldError{Field: "Tags", Rule: "dive", Message: "Tags must be an array of strings"})
+		} else {
+			for _, tag := range tags {
+				if tag == "" {
+					fieldErrors = append(fieldErrors, FieldError{Field: "Tags", Rule: "required", Message: "Tags must not contain empty strings"})
+					break
+				}
+			}
+		}
+	}
+
+	if value, present := patch["description"]; present && value != nil {
+		if _, ok := value.(string); !ok {
+			fieldErrors = append(fieldErrors, FieldError{Field: "Description", Rule: "type", Message: "Description must be a string"})
+		}
+	}
+
+	return fieldErrors
+}
+
+func fieldErrorMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return fe.Field() + " is required"
+	case "alphanum":
+		return fe.Field() + " must contain only letters and numbers"
+	case "min":
+		return fe.Field() + " must be at least " + fe.Param() + " characters"
+	case "max":
+		return fe.Field() + " must be at most " + fe.Param() + " characters"
+	case "url":
+		return fe.Field() + " must be a valid URL"
+	default:
+		return fe.Field() + " is invalid"
+	}
+}